@@ -9,7 +9,6 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
-	"sync/atomic"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -23,6 +22,14 @@ import (
 // pool_health_check_period: duration string
 // pool_max_conn_lifetime_jitter: duration string
 //
+// PGSSLMODE is validated against the libpq sslmode values; an unrecognized value is dropped with a warning.
+// PGSSLSNI is forwarded as a query parameter, as pgx's own config parser recognizes it directly. PGSSLCERT,
+// PGSSLKEY, PGSSLPASSWORD, and PGSSLCRL are deliberately NOT forwarded here -- pgx's parser only recognizes
+// "sslcert"/"sslkey"/"sslpassword" as query keys, and doesn't recognize "sslcrl" at all, so a forwarded
+// sslcrl value falls through into ConnConfig.RuntimeParams and is sent to Postgres as a session parameter,
+// which the server rejects outright. All four are instead handled by [ConfigureTLS], which loads them
+// directly into a *tls.Config.
+//
 //   - https://www.postgresql.org/docs/current/libpq-envars.html
 //   - https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-PARAMKEYWORDS
 func DSN() (v string) {
@@ -50,7 +57,13 @@ func DSN() (v string) {
 	application := os.Getenv("PGAPPNAME")
 
 	sslmode := os.Getenv("PGSSLMODE")
+	if sslmode != "" && !sslmodes[sslmode] {
+		slog.Warn("Ignoring Invalid PGSSLMODE Value", slog.String("sslmode", sslmode))
+		sslmode = ""
+	}
+
 	root := os.Getenv("PGSSLROOTCERT")
+	sni := os.Getenv("PGSSLSNI")
 
 	maxconnections := os.Getenv("PGPOOLMAXCONNECTIONS")
 	if maxconnections == "" {
@@ -85,10 +98,11 @@ func DSN() (v string) {
 
 	query.Add("sslmode", sslmode)
 	query.Add("sslrootcert", root)
+	query.Add("sslsni", sni)
 
-	for key, values := range query {
+	for name, values := range query {
 		if len(values) >= 1 && strings.TrimSpace(values[0]) == "" {
-			query.Del(key)
+			query.Del(name)
 		}
 	}
 
@@ -97,44 +111,35 @@ func DSN() (v string) {
 	return uri.String()
 }
 
-var Pool atomic.Pointer[pgxpool.Pool]
-
-// Connection establishes a connection to the database using pgxpool.
-// If a connection pool does not exist, a new one is created and stored in the pool variable.
+// Connection establishes a connection to the database using pgxpool, acquiring from the named pool.
+// If the named pool does not yet exist, a new one is created via [Connect] and registered in [Pools].
 // Returns a connection from the connection pool.
 // If an error occurs during connection creation, nil and the error are returned.
-func Connection(ctx context.Context, uri string) (*pgxpool.Conn, error) {
-	if Pool.Load() == nil {
-		configuration, e := pgxpool.ParseConfig(uri)
-		if e != nil {
-			slog.ErrorContext(ctx, "Unable to Generate Configuration from DSN String", slog.String("error", e.Error()))
-			return nil, e
-		}
-
-		instance, e := pgxpool.NewWithConfig(ctx, configuration)
-		if e != nil {
-			slog.ErrorContext(ctx, "Unable to Establish Pool Connection to Database", slog.String("error", e.Error()))
-			return nil, e
-		}
-
-		Pool.Store(instance)
+func Connection(ctx context.Context, name string, uri string, options ...Variadic) (*pgxpool.Conn, error) {
+	instance, e := Connect(ctx, name, uri, options...)
+	if e != nil {
+		return nil, e
 	}
 
-	return Pool.Load().Acquire(ctx)
+	ctx, done := span(ctx, "pg.Acquire")
+	connection, e := instance.Acquire(ctx)
+	done(e)
+
+	return connection, e
 }
 
-// Disconnect closes the transaction and releases the connection back to the pool.
+// Disconnect closes the transaction and releases the connection back to its originating, named pool.
 // If `tx` is not nil, it rolls back the transaction and logs any error.
 // If `connection` is not nil, it releases the connection back to the pool.
-func Disconnect(ctx context.Context, connection *pgxpool.Conn, tx pgx.Tx) {
+func Disconnect(ctx context.Context, name string, connection *pgxpool.Conn, tx pgx.Tx) {
 	if tx != nil {
 		e := tx.Rollback(ctx)
 		if e != nil && !(errors.Is(e, pgx.ErrTxClosed)) {
-			slog.ErrorContext(ctx, "Error Rolling Back Transaction", slog.String("error", e.Error()))
+			slog.ErrorContext(ctx, "Error Rolling Back Transaction", slog.String("pool", name), slog.String("error", e.Error()))
 		} else if e != nil && (errors.Is(e, pgx.ErrTxClosed)) {
-			slog.InfoContext(ctx, "Successfully Committed Database Transaction")
+			slog.InfoContext(ctx, "Successfully Committed Database Transaction", slog.String("pool", name))
 		} else if e == nil {
-			slog.InfoContext(ctx, "Successfully Rolled Back Database Transaction")
+			slog.InfoContext(ctx, "Successfully Rolled Back Database Transaction", slog.String("pool", name))
 		}
 	}
 
@@ -0,0 +1,175 @@
+package pg
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Listener is a LISTEN/NOTIFY subscriber built on a dedicated, pool-bypassing *pgx.Conn. A Listener holds
+// a single long-lived connection so notification delivery isn't at the mercy of pool acquire/release
+// churn, and it transparently reconnects -- re-issuing LISTEN for every subscribed channel -- on connection loss.
+type Listener struct {
+	uri string
+
+	mutex    sync.Mutex
+	handlers map[string]func(context.Context, *pgconn.Notification)
+	conn     *pgx.Conn
+
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+// NewListener constructs a Listener that dials uri -- typically the result of [DSN] -- once [Listener.Start] is called.
+func NewListener(uri string) *Listener {
+	return &Listener{
+		uri:      uri,
+		handlers: make(map[string]func(context.Context, *pgconn.Notification)),
+	}
+}
+
+// Subscribe registers handler to be invoked for every notification delivered on channel. If the Listener is
+// already running, channel is LISTEN-ed on immediately; otherwise it takes effect on the next [Listener.Start].
+func (l *Listener) Subscribe(ctx context.Context, channel string, handler func(context.Context, *pgconn.Notification)) error {
+	l.mutex.Lock()
+	l.handlers[channel] = handler
+	conn := l.conn
+	l.mutex.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+
+	_, e := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+
+	return e
+}
+
+// Start dials the database and begins dispatching notifications in a background goroutine, reconnecting
+// with backoff -- and re-issuing LISTEN for every subscribed channel -- on connection loss. Start returns
+// once the first connection attempt succeeds; call [Listener.Stop] to terminate it.
+func (l *Listener) Start(ctx context.Context) error {
+	conn, e := l.connect(ctx)
+	if e != nil {
+		return e
+	}
+
+	loop, cancel := context.WithCancel(ctx)
+	l.cancel = cancel
+	l.stopped = make(chan struct{})
+
+	go l.run(loop, conn)
+
+	return nil
+}
+
+// Stop terminates the background dispatch goroutine and closes the underlying connection.
+func (l *Listener) Stop(ctx context.Context) {
+	if l.cancel == nil {
+		return
+	}
+
+	l.cancel()
+	<-l.stopped
+
+	l.mutex.Lock()
+	conn := l.conn
+	l.conn = nil
+	l.mutex.Unlock()
+
+	if conn != nil {
+		conn.Close(ctx)
+	}
+}
+
+// connect dials a new *pgx.Conn, stores it as the Listener's current connection, and re-issues LISTEN for
+// every channel currently registered via [Listener.Subscribe].
+func (l *Listener) connect(ctx context.Context) (*pgx.Conn, error) {
+	conn, e := pgx.Connect(ctx, l.uri)
+	if e != nil {
+		return nil, e
+	}
+
+	l.mutex.Lock()
+	l.conn = conn
+	channels := make([]string, 0, len(l.handlers))
+	for channel := range l.handlers {
+		channels = append(channels, channel)
+	}
+	l.mutex.Unlock()
+
+	for _, channel := range channels {
+		if _, e := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); e != nil {
+			conn.Close(ctx)
+
+			l.mutex.Lock()
+			l.conn = nil
+			l.mutex.Unlock()
+
+			return nil, e
+		}
+	}
+
+	return conn, nil
+}
+
+// run dispatches notifications from conn to their registered handlers until ctx is done, reconnecting with
+// exponential backoff whenever conn is lost. While conn is nil -- reconnecting -- [Listener.conn] is also
+// nil under the mutex, so a concurrent [Listener.Subscribe] doesn't attempt I/O against a dead connection.
+func (l *Listener) run(ctx context.Context, conn *pgx.Conn) {
+	defer close(l.stopped)
+
+	backoff := 250 * time.Millisecond
+
+	for {
+		if conn == nil {
+			reconnected, e := l.connect(ctx)
+			if e != nil {
+				slog.ErrorContext(ctx, "Listener Reconnect Failed", slog.String("error", e.Error()))
+				if backoff < 30*time.Second {
+					backoff *= 2
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(backoff):
+				}
+
+				continue
+			}
+
+			conn = reconnected
+			backoff = 250 * time.Millisecond
+		}
+
+		notification, e := conn.WaitForNotification(ctx)
+		if e != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			slog.WarnContext(ctx, "Listener Lost Connection, Reconnecting", slog.String("error", e.Error()))
+			conn.Close(ctx)
+			conn = nil
+
+			l.mutex.Lock()
+			l.conn = nil
+			l.mutex.Unlock()
+
+			continue
+		}
+
+		l.mutex.Lock()
+		handler := l.handlers[notification.Channel]
+		l.mutex.Unlock()
+
+		if handler != nil {
+			handler(ctx, notification)
+		}
+	}
+}
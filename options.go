@@ -1,5 +1,12 @@
 package pg
 
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
 type Warnings struct {
 	Empty   bool // Empty represents a logging option to warn if a given environment variable is set to any empty string. Requires [Options.Variables]. Defaults to false.
 	Missing bool // Missing represents a logging option to warn if a given environment variable isn't found. Requires [Options.Variables]. Defaults to false.
@@ -9,6 +16,31 @@ type Warnings struct {
 type Options struct {
 	Variables []string  // Variables represents an array of environment variables (as returned by [os.Environ]), to selectively log.
 	Warnings  *Warnings // Warnings represents logging options relating to [slog.Warn] logs. Defaults to a non-nil [Warnings] reference with all attributes set to false.
+
+	MaxConnLifetime       time.Duration // MaxConnLifetime represents the maximum lifetime of a pooled connection, mapped to [pgxpool.Config.MaxConnLifetime].
+	MaxConnLifetimeJitter time.Duration // MaxConnLifetimeJitter represents jitter applied against MaxConnLifetime, mapped to [pgxpool.Config.MaxConnLifetimeJitter].
+	MaxConnIdleTime       time.Duration // MaxConnIdleTime represents the maximum idle time of a pooled connection, mapped to [pgxpool.Config.MaxConnIdleTime].
+	HealthCheckPeriod     time.Duration // HealthCheckPeriod represents the interval between pool health checks, mapped to [pgxpool.Config.HealthCheckPeriod].
+
+	ConnectTimeout time.Duration // ConnectTimeout represents the per-connection dial timeout, mapped to [pgxpool.Config.ConnConfig.ConnectTimeout].
+
+	StatementCacheCapacity   int               // StatementCacheCapacity represents the size of the prepared-statement cache, mapped to [pgxpool.Config.ConnConfig.StatementCacheCapacity].
+	DescriptionCacheCapacity int               // DescriptionCacheCapacity represents the size of the statement-description cache, mapped to [pgxpool.Config.ConnConfig.DescriptionCacheCapacity].
+	QueryExecMode            pgx.QueryExecMode // QueryExecMode represents the default query execution mode, mapped to [pgxpool.Config.ConnConfig.DefaultQueryExecMode].
+
+	ConnRetry int // ConnRetry represents the number of additional attempts [Connect] makes -- with exponential backoff -- when pool creation or its post-creation health check fails.
+
+	TxRetry int // TxRetry represents the number of additional attempts [WithTx] makes -- with exponential backoff -- when the callback fails with a serialization failure or deadlock.
+
+	// CredentialsProvider, when set, is mapped to [pgxpool.Config.BeforeConnect] and invoked before every new
+	// physical connection, so each one authenticates with fresh, short-lived credentials (Vault dynamic secrets,
+	// IAM RDS auth tokens, Cloud SQL IAM) instead of the user/password [DSN] baked in at process start.
+	CredentialsProvider func(ctx context.Context) (user string, password string, err error)
+
+	// ValidateConnect, when set, is mapped to [pgxpool.Config.AfterConnect] and invoked after every new physical
+	// connection -- and after the named statements registered via [Queries.Load] are prepared -- for
+	// callers that need additional schema checks or session-level SET statements.
+	ValidateConnect func(ctx context.Context, conn *pgx.Conn) error
 }
 
 // Variadic represents a functional constructor for the [Options] type. Typical callers of Variadic won't need to perform
@@ -25,5 +57,20 @@ func Settings() *Options {
 			Empty:   false,
 			Missing: false,
 		},
+
+		MaxConnLifetime:       time.Hour,
+		MaxConnLifetimeJitter: 0,
+		MaxConnIdleTime:       30 * time.Minute,
+		HealthCheckPeriod:     time.Minute,
+
+		ConnectTimeout: 10 * time.Second,
+
+		StatementCacheCapacity:   512,
+		DescriptionCacheCapacity: 512,
+		QueryExecMode:            pgx.QueryExecModeCacheStatement,
+
+		ConnRetry: 3,
+
+		TxRetry: 3,
 	}
 }
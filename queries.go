@@ -0,0 +1,88 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Queries is the registry of named SQL statements loaded via [Queries.Load]. The zero value is ready to use.
+var Queries = &statements{}
+
+// statements is a registry of named SQL text, keyed by a name derived from its source file's path.
+type statements struct {
+	values sync.Map // map[string]string
+}
+
+// Load walks fsys rooted at directory, registering every *.sql file found by a name derived from its path
+// relative to directory: the .sql extension is stripped and path separators are replaced with ".", e.g. a
+// file at "user/get-by-email.sql" is registered as "user.get-by-email".
+func (s *statements) Load(fsys fs.FS, directory string) error {
+	directory = strings.TrimSuffix(directory, "/")
+	if directory == "" {
+		directory = "."
+	}
+
+	return fs.WalkDir(fsys, directory, func(p string, d fs.DirEntry, e error) error {
+		if e != nil {
+			return e
+		}
+
+		if d.IsDir() || !strings.HasSuffix(p, ".sql") {
+			return nil
+		}
+
+		raw, e := fs.ReadFile(fsys, p)
+		if e != nil {
+			return fmt.Errorf("unable to read %s: %w", p, e)
+		}
+
+		relative := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(p, directory), "/"), ".sql")
+		name := strings.ReplaceAll(relative, "/", ".")
+
+		s.values.Store(name, string(raw))
+
+		return nil
+	})
+}
+
+// Get returns the named SQL statement previously registered via [Queries.Load].
+func (s *statements) Get(name string) (string, bool) {
+	value, ok := s.values.Load(name)
+	if !ok {
+		return "", false
+	}
+
+	return value.(string), true
+}
+
+// AfterConnect prepares every registered statement on conn, keyed by its registered name. It is intended
+// for use as a [pgxpool.Config] AfterConnect hook so named queries are ready to go on every new, pooled
+// physical connection.
+func (s *statements) AfterConnect(ctx context.Context, conn *pgx.Conn) (e error) {
+	s.values.Range(func(key, value any) bool {
+		if _, e = conn.Prepare(ctx, key.(string), value.(string)); e != nil {
+			e = fmt.Errorf("unable to prepare statement %q: %w", key, e)
+			return false
+		}
+
+		return true
+	})
+
+	return e
+}
+
+// QueryNamed runs the registered statement name -- previously loaded via [Queries.Load] and prepared on
+// conn's underlying connection by [statements.AfterConnect] -- against conn, passing args as parameters.
+func QueryNamed(ctx context.Context, conn *pgxpool.Conn, name string, args ...any) (pgx.Rows, error) {
+	if _, ok := Queries.Get(name); !ok {
+		return nil, fmt.Errorf("query %q is not registered", name)
+	}
+
+	return conn.Query(ctx, name, args...)
+}
@@ -0,0 +1,68 @@
+package pg
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestStatementsLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"queries/user/get-by-email.sql": {Data: []byte("select 1")},
+		"queries/ping.sql":              {Data: []byte("select 2")},
+		"other/ignored.txt":             {Data: []byte("not sql")},
+	}
+
+	cases := []struct {
+		name      string
+		directory string
+	}{
+		{name: "plain", directory: "queries"},
+		{name: "trailing slash", directory: "queries/"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := &statements{}
+
+			if e := s.Load(fsys, c.directory); e != nil {
+				t.Fatalf("Load(%q): unexpected error: %v", c.directory, e)
+			}
+
+			if _, ok := s.Get("user.get-by-email"); !ok {
+				t.Errorf("expected %q to be registered", "user.get-by-email")
+			}
+
+			if _, ok := s.Get("ping"); !ok {
+				t.Errorf("expected %q to be registered", "ping")
+			}
+
+			if _, ok := s.Get("ignored"); ok {
+				t.Errorf("did not expect a non-.sql file to be registered")
+			}
+		})
+	}
+}
+
+func TestStatementsLoadRootDirectory(t *testing.T) {
+	fsys := fstest.MapFS{
+		"ping.sql": {Data: []byte("select 1")},
+	}
+
+	s := &statements{}
+
+	if e := s.Load(fsys, "."); e != nil {
+		t.Fatalf("Load(.): unexpected error: %v", e)
+	}
+
+	if _, ok := s.Get("ping"); !ok {
+		t.Errorf("expected %q to be registered", "ping")
+	}
+}
+
+func TestStatementsGetMissing(t *testing.T) {
+	s := &statements{}
+
+	if _, ok := s.Get("missing"); ok {
+		t.Errorf("expected %q to be unregistered", "missing")
+	}
+}
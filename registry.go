@@ -0,0 +1,168 @@
+package pg
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Pools is the registry of named connection pools, keyed by an arbitrary caller-chosen identifier
+// such as a tenant, a read/write role, or a database name. Prefer [Connect], [Lookup], [Close], [CloseAll],
+// and [Range] over reading or writing Pools directly; it is exported so advanced callers can range over it
+// using the standard [sync.Map] API when the helpers below aren't sufficient.
+var Pools sync.Map // map[string]*pgxpool.Pool
+
+// Connect establishes, or returns the already-established, named connection pool identified by name.
+// If a pool already exists for name, it is returned as-is and uri and options are ignored.
+// If an error occurs during pool creation, nil and the error are returned.
+func Connect(ctx context.Context, name string, uri string, options ...Variadic) (*pgxpool.Pool, error) {
+	if instance, ok := Pools.Load(name); ok {
+		return instance.(*pgxpool.Pool), nil
+	}
+
+	settings := Settings()
+	for _, option := range options {
+		option(settings)
+	}
+
+	configuration, e := pgxpool.ParseConfig(uri)
+	if e != nil {
+		slog.ErrorContext(ctx, "Unable to Generate Configuration from DSN String", slog.String("pool", name), slog.String("error", e.Error()))
+		return nil, e
+	}
+
+	if e := ConfigureTLS(configuration); e != nil {
+		slog.ErrorContext(ctx, "Unable to Configure TLS for Pool", slog.String("pool", name), slog.String("error", e.Error()))
+		return nil, e
+	}
+
+	configuration.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+		if e := Queries.AfterConnect(ctx, conn); e != nil {
+			return e
+		}
+
+		if settings.ValidateConnect != nil {
+			return settings.ValidateConnect(ctx, conn)
+		}
+
+		return nil
+	}
+
+	if settings.CredentialsProvider != nil {
+		configuration.BeforeConnect = func(ctx context.Context, cc *pgx.ConnConfig) error {
+			user, password, e := settings.CredentialsProvider(ctx)
+			if e != nil {
+				return e
+			}
+
+			cc.User = user
+			cc.Password = password
+
+			return nil
+		}
+	}
+
+	if telemetric := telemetry.Load(); telemetric != nil {
+		configuration.ConnConfig.Tracer = &tracer{tracer: telemetric.TracerProvider.Tracer(instrumentation)}
+	}
+
+	configuration.MaxConnLifetime = settings.MaxConnLifetime
+	configuration.MaxConnLifetimeJitter = settings.MaxConnLifetimeJitter
+	configuration.MaxConnIdleTime = settings.MaxConnIdleTime
+	configuration.HealthCheckPeriod = settings.HealthCheckPeriod
+
+	configuration.ConnConfig.ConnectTimeout = settings.ConnectTimeout
+	configuration.ConnConfig.StatementCacheCapacity = settings.StatementCacheCapacity
+	configuration.ConnConfig.DescriptionCacheCapacity = settings.DescriptionCacheCapacity
+	configuration.ConnConfig.DefaultQueryExecMode = settings.QueryExecMode
+
+	instance, e := dial(ctx, name, configuration, settings.ConnRetry)
+	if e != nil {
+		return nil, e
+	}
+
+	actual, loaded := Pools.LoadOrStore(name, instance)
+	if loaded {
+		instance.Close() // lost the race against a concurrent Connect(ctx, name, ...) call
+	}
+
+	return actual.(*pgxpool.Pool), nil
+}
+
+// dial creates a pool from configuration and confirms it's actually reachable with a [pgxpool.Pool.Ping],
+// retrying up to retry additional times with exponential backoff on either failure. This keeps a transient
+// database outage at boot from caching a broken pool in [Pools].
+func dial(ctx context.Context, name string, configuration *pgxpool.Config, retry int) (*pgxpool.Pool, error) {
+	var e error
+	backoff := 250 * time.Millisecond
+
+	for attempt := 0; attempt <= retry; attempt++ {
+		if attempt > 0 {
+			slog.WarnContext(ctx, "Retrying Database Pool Connection", slog.String("pool", name), slog.Int("attempt", attempt), slog.Duration("backoff", backoff))
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+		}
+
+		instance, e2 := pgxpool.NewWithConfig(ctx, configuration)
+		if e2 != nil {
+			e = e2
+			slog.ErrorContext(ctx, "Unable to Establish Pool Connection to Database", slog.String("pool", name), slog.String("error", e.Error()))
+			continue
+		}
+
+		if e2 = instance.Ping(ctx); e2 != nil {
+			e = e2
+			slog.ErrorContext(ctx, "Pool Health Check Failed", slog.String("pool", name), slog.String("error", e.Error()))
+			instance.Close()
+			continue
+		}
+
+		return instance, nil
+	}
+
+	return nil, e
+}
+
+// Lookup returns the named pool previously established via [Connect], if one exists.
+func Lookup(name string) (*pgxpool.Pool, bool) {
+	instance, ok := Pools.Load(name)
+	if !ok {
+		return nil, false
+	}
+
+	return instance.(*pgxpool.Pool), true
+}
+
+// Close closes and removes the named pool from [Pools], if present. Close is a no-op if name isn't registered.
+func Close(name string) {
+	if instance, ok := Pools.LoadAndDelete(name); ok {
+		instance.(*pgxpool.Pool).Close()
+	}
+}
+
+// CloseAll closes and removes every pool currently in [Pools].
+func CloseAll() {
+	Pools.Range(func(key, value any) bool {
+		value.(*pgxpool.Pool).Close()
+		Pools.Delete(key)
+
+		return true
+	})
+}
+
+// Range iterates over every named pool currently in [Pools], stopping early if fn returns false.
+func Range(fn func(name string, pool *pgxpool.Pool) bool) {
+	Pools.Range(func(key, value any) bool {
+		return fn(key.(string), value.(*pgxpool.Pool))
+	})
+}
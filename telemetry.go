@@ -0,0 +1,147 @@
+package pg
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentation is the OpenTelemetry instrumentation-library name reported against every span and
+// metric this package produces.
+const instrumentation = "github.com/x-ethr/pg"
+
+// Telemetry configures the OpenTelemetry integration enabled via [Instrument]. A nil Telemetry -- the
+// package default -- leaves pools uninstrumented.
+type Telemetry struct {
+	TracerProvider  trace.TracerProvider // TracerProvider constructs the tracer used for Query/Exec/Begin/Acquire spans. Defaults to [otel.GetTracerProvider] if nil.
+	MeterProvider   metric.MeterProvider // MeterProvider constructs the meter used for pool-statistics gauges. Defaults to [otel.GetMeterProvider] if nil.
+	MetricsInterval time.Duration        // MetricsInterval is how often [Pools] statistics are published. Defaults to 15 seconds.
+}
+
+// telemetry is the package-level telemetry configuration populated by [Instrument]. A nil value (the
+// default) means [Connect] leaves new pools uninstrumented. Stored behind an atomic.Pointer since it's
+// written by [Instrument] and read by [Connect] and [span] from arbitrary goroutines.
+var telemetry atomic.Pointer[Telemetry]
+
+// TelemetryOption represents a functional constructor for the [Telemetry] type passed to [Instrument].
+// Unlike [Variadic], TelemetryOption mutates a [Telemetry], not an [Options] -- telemetry is configured
+// once, globally, via [Instrument], rather than per-[Connect] call, so it doesn't belong on [Options].
+type TelemetryOption func(t *Telemetry)
+
+// Instrument enables OpenTelemetry tracing and pool-metrics publishing for every pool subsequently
+// established via [Connect]. Call Instrument once, before establishing any pools -- pools created before
+// Instrument runs are not retroactively instrumented.
+func Instrument(ctx context.Context, options ...TelemetryOption) error {
+	configuration := &Telemetry{}
+	for _, option := range options {
+		option(configuration)
+	}
+
+	if configuration.TracerProvider == nil {
+		configuration.TracerProvider = otel.GetTracerProvider()
+	}
+
+	if configuration.MeterProvider == nil {
+		configuration.MeterProvider = otel.GetMeterProvider()
+	}
+
+	if configuration.MetricsInterval <= 0 {
+		configuration.MetricsInterval = 15 * time.Second
+	}
+
+	telemetry.Store(configuration)
+
+	gauge, e := configuration.MeterProvider.Meter(instrumentation).Int64Gauge("pg.pool.connections")
+	if e != nil {
+		return e
+	}
+
+	go publish(ctx, gauge, configuration.MetricsInterval)
+
+	return nil
+}
+
+// publish periodically records [pgxpool.Pool.Stat] for every pool in [Pools] against gauge, tagged by pool
+// name and state (acquired/idle/total conns, plus the canceled_acquires count), until ctx is done.
+func publish(ctx context.Context, gauge metric.Int64Gauge, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			Range(func(name string, pool *pgxpool.Pool) bool {
+				stat := pool.Stat()
+
+				gauge.Record(ctx, int64(stat.AcquiredConns()), metric.WithAttributes(attribute.String("pool", name), attribute.String("state", "acquired")))
+				gauge.Record(ctx, int64(stat.IdleConns()), metric.WithAttributes(attribute.String("pool", name), attribute.String("state", "idle")))
+				gauge.Record(ctx, int64(stat.TotalConns()), metric.WithAttributes(attribute.String("pool", name), attribute.String("state", "total")))
+				gauge.Record(ctx, stat.CanceledAcquireCount(), metric.WithAttributes(attribute.String("pool", name), attribute.String("state", "canceled_acquires")))
+
+				return true
+			})
+		}
+	}
+}
+
+// tracer implements [pgx.QueryTracer], emitting a span per Query/Exec, and is attached to
+// [pgxpool.Config.ConnConfig.Tracer] by [Connect] whenever [Instrument] has been called.
+type tracer struct {
+	tracer trace.Tracer
+}
+
+// queryTracerSpanKey is the context key [tracer.TraceQueryStart] stashes its span under for
+// [tracer.TraceQueryEnd] to retrieve.
+type queryTracerSpanKey struct{}
+
+func (t *tracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, span := t.tracer.Start(ctx, "pg.Query", trace.WithAttributes(attribute.String("db.statement", data.SQL)))
+	return context.WithValue(ctx, queryTracerSpanKey{}, span)
+}
+
+func (t *tracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span, ok := ctx.Value(queryTracerSpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+
+	span.End()
+}
+
+// span starts a child span named operation when instrumentation is enabled, returning the span's context and
+// a func(error) that records the error (if any) and ends the span. When instrumentation isn't enabled, ctx is
+// returned unchanged with a no-op func(error), so callers can unconditionally wrap a call:
+// `ctx, done := span(ctx, "pg.Acquire"); ...; done(e)`.
+func span(ctx context.Context, operation string) (context.Context, func(error)) {
+	configuration := telemetry.Load()
+	if configuration == nil {
+		return ctx, func(error) {}
+	}
+
+	ctx, s := configuration.TracerProvider.Tracer(instrumentation).Start(ctx, operation)
+
+	return ctx, func(e error) {
+		if e != nil {
+			s.RecordError(e)
+			s.SetStatus(codes.Error, e.Error())
+		}
+
+		s.End()
+	}
+}
+
@@ -0,0 +1,38 @@
+package pg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	sdkmetricdata "go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// TestInstrument runs Instrument against a real SDK meter provider backed by a manual reader, asserting that
+// it creates the "pg.pool.connections" gauge without error, stores the telemetry configuration, and that the
+// reader can then be collected from without error.
+func TestInstrument(t *testing.T) {
+	reader := metric.NewManualReader()
+	provider := metric.NewMeterProvider(metric.WithReader(reader))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := Instrument(ctx, func(telemetry *Telemetry) {
+		telemetry.MeterProvider = provider
+		telemetry.MetricsInterval = 10 * time.Millisecond
+	})
+	if e != nil {
+		t.Fatalf("Instrument() error = %v", e)
+	}
+
+	if configuration := telemetry.Load(); configuration == nil {
+		t.Fatal("telemetry.Load() = nil, want non-nil after Instrument")
+	}
+
+	var data sdkmetricdata.ResourceMetrics
+	if e := reader.Collect(ctx, &data); e != nil {
+		t.Fatalf("reader.Collect() error = %v", e)
+	}
+}
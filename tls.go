@@ -0,0 +1,230 @@
+package pg
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// sslmodes enumerates the libpq sslmode values [ConfigureTLS] understands.
+//   - https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNECT-SSLMODE
+var sslmodes = map[string]bool{
+	"disable":     true,
+	"allow":       true,
+	"prefer":      true,
+	"require":     true,
+	"verify-ca":   true,
+	"verify-full": true,
+}
+
+// ConfigureTLS builds a *tls.Config from the PGSSL* environment variables and attaches it to
+// configuration.ConnConfig.TLSConfig. Unlike the query-string sslmode/sslrootcert forwarded by [DSN],
+// ConfigureTLS also loads PGSSLCERT/PGSSLKEY so client-certificate (mutual TLS) authentication works.
+//
+//   - https://www.postgresql.org/docs/current/libpq-envars.html
+func ConfigureTLS(configuration *pgxpool.Config) error {
+	mode := os.Getenv("PGSSLMODE")
+	if mode == "" {
+		mode = "prefer"
+	}
+
+	if !sslmodes[mode] {
+		return fmt.Errorf("invalid PGSSLMODE value %q", mode)
+	}
+
+	if mode == "disable" {
+		configuration.ConnConfig.TLSConfig = nil
+		return nil
+	}
+
+	tlsconfig := &tls.Config{
+		ServerName:         configuration.ConnConfig.Host,
+		InsecureSkipVerify: mode != "verify-full",
+	}
+
+	if os.Getenv("PGSSLSNI") == "0" {
+		tlsconfig.ServerName = ""
+	}
+
+	var verifiers []func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error
+
+	if mode == "verify-ca" || mode == "verify-full" {
+		root := os.Getenv("PGSSLROOTCERT")
+		if root == "" {
+			return fmt.Errorf("PGSSLMODE %q requires PGSSLROOTCERT", mode)
+		}
+
+		pool, e := loadCertificatePool(root)
+		if e != nil {
+			return fmt.Errorf("unable to load PGSSLROOTCERT: %w", e)
+		}
+
+		tlsconfig.RootCAs = pool
+
+		if mode == "verify-ca" {
+			// InsecureSkipVerify disables Go's default verification wholesale, chain-of-trust included, not
+			// just the hostname check -- so verify-ca must reimplement chain verification against RootCAs
+			// itself via VerifyPeerCertificate, skipping only the hostname comparison verify-full performs.
+			tlsconfig.InsecureSkipVerify = true
+			verifiers = append(verifiers, verifyChain(pool))
+		}
+	}
+
+	if cert, key := os.Getenv("PGSSLCERT"), os.Getenv("PGSSLKEY"); cert != "" && key != "" {
+		certificate, e := loadX509KeyPair(cert, key, os.Getenv("PGSSLPASSWORD"))
+		if e != nil {
+			return fmt.Errorf("unable to load PGSSLCERT/PGSSLKEY: %w", e)
+		}
+
+		tlsconfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if crl := os.Getenv("PGSSLCRL"); crl != "" {
+		verify, e := loadCRLVerifier(crl)
+		if e != nil {
+			return fmt.Errorf("unable to load PGSSLCRL: %w", e)
+		}
+
+		verifiers = append(verifiers, verify)
+	}
+
+	if len(verifiers) > 0 {
+		tlsconfig.VerifyPeerCertificate = verifyAll(verifiers)
+	}
+
+	configuration.ConnConfig.TLSConfig = tlsconfig
+
+	return nil
+}
+
+// verifyAll combines verifiers into a single tls.Config.VerifyPeerCertificate callback that runs each in
+// turn, failing on the first error.
+func verifyAll(verifiers []func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error) func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		for _, verify := range verifiers {
+			if e := verify(rawCerts, verifiedChains); e != nil {
+				return e
+			}
+		}
+
+		return nil
+	}
+}
+
+// verifyChain returns a tls.Config.VerifyPeerCertificate callback that verifies the presented certificate
+// chain against roots -- without checking the certificate's hostname -- matching libpq's verify-ca
+// semantics. It's only safe to use alongside InsecureSkipVerify; verify-full relies on Go's default
+// verifier instead, which additionally checks the hostname.
+func verifyChain(roots *x509.CertPool) func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("no certificate presented")
+		}
+
+		leaf, e := x509.ParseCertificate(rawCerts[0])
+		if e != nil {
+			return e
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, raw := range rawCerts[1:] {
+			certificate, e := x509.ParseCertificate(raw)
+			if e != nil {
+				return e
+			}
+
+			intermediates.AddCert(certificate)
+		}
+
+		_, e = leaf.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+
+		return e
+	}
+}
+
+// loadCertificatePool reads a PEM-encoded CA bundle from path into an *x509.CertPool.
+func loadCertificatePool(path string) (*x509.CertPool, error) {
+	raw, e := os.ReadFile(path)
+	if e != nil {
+		return nil, e
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(raw) {
+		return nil, fmt.Errorf("no certificates parsed from %s", path)
+	}
+
+	return pool, nil
+}
+
+// loadX509KeyPair reads a client certificate/key pair from cert and key, decrypting key with password
+// when it is PEM-encrypted. password may be empty for an unencrypted key.
+func loadX509KeyPair(cert string, key string, password string) (tls.Certificate, error) {
+	certificate, e := os.ReadFile(cert)
+	if e != nil {
+		return tls.Certificate{}, e
+	}
+
+	raw, e := os.ReadFile(key)
+	if e != nil {
+		return tls.Certificate{}, e
+	}
+
+	if password != "" {
+		block, _ := pem.Decode(raw)
+		if block == nil {
+			return tls.Certificate{}, fmt.Errorf("no PEM block found in %s", key)
+		}
+
+		decrypted, e := x509.DecryptPEMBlock(block, []byte(password)) // nolint:staticcheck // PGSSLKEY may be a classic encrypted PEM key
+		if e != nil {
+			return tls.Certificate{}, fmt.Errorf("unable to decrypt PGSSLKEY: %w", e)
+		}
+
+		raw = pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: decrypted})
+	}
+
+	return tls.X509KeyPair(certificate, raw)
+}
+
+// loadCRLVerifier reads a PEM-encoded certificate revocation list from path and returns a
+// tls.Config.VerifyPeerCertificate callback that rejects any certificate whose serial number is listed.
+func loadCRLVerifier(path string) (func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error, error) {
+	raw, e := os.ReadFile(path)
+	if e != nil {
+		return nil, e
+	}
+
+	list, e := x509.ParseCRL(raw) // nolint:staticcheck // PGSSLCRL is a classic (RFC 5280) CRL file
+	if e != nil {
+		return nil, e
+	}
+
+	revoked := make(map[string]bool, len(list.TBSCertList.RevokedCertificates))
+	for _, entry := range list.TBSCertList.RevokedCertificates {
+		revoked[entry.SerialNumber.String()] = true
+	}
+
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		for _, raw := range rawCerts {
+			certificate, e := x509.ParseCertificate(raw)
+			if e != nil {
+				return e
+			}
+
+			if revoked[certificate.SerialNumber.String()] {
+				return fmt.Errorf("certificate %s is revoked", certificate.SerialNumber.String())
+			}
+		}
+
+		return nil
+	}, nil
+}
@@ -0,0 +1,62 @@
+package pg
+
+import (
+	"crypto/x509"
+	"testing"
+)
+
+func TestSSLModes(t *testing.T) {
+	valid := []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+	for _, mode := range valid {
+		if !sslmodes[mode] {
+			t.Errorf("expected sslmodes[%q] to be true", mode)
+		}
+	}
+
+	if sslmodes["bogus"] {
+		t.Errorf("expected sslmodes[%q] to be false", "bogus")
+	}
+}
+
+func TestVerifyChainRejectsMissingOrUnparsableCertificate(t *testing.T) {
+	roots := x509.NewCertPool() // empty -- trusts nothing
+
+	verify := verifyChain(roots)
+
+	if e := verify(nil, nil); e == nil {
+		t.Fatal("expected an error when no certificate is presented")
+	}
+
+	if e := verify([][]byte{[]byte("not a certificate")}, nil); e == nil {
+		t.Fatal("expected an error for an unparsable certificate")
+	}
+}
+
+func TestVerifyAllStopsAtFirstError(t *testing.T) {
+	var calls int
+
+	ok := func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		calls++
+		return nil
+	}
+
+	failing := func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		calls++
+		return x509.CertificateInvalidError{Reason: x509.Expired}
+	}
+
+	unreached := func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		t.Fatal("unreached should not be called once a prior verifier fails")
+		return nil
+	}
+
+	verify := verifyAll([]func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error{ok, failing, unreached})
+
+	if e := verify(nil, nil); e == nil {
+		t.Fatal("expected verifyAll to surface the failing verifier's error")
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected exactly 2 verifiers to run, got %d", calls)
+	}
+}
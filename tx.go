@@ -0,0 +1,84 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// WithTx begins a transaction on conn, invokes fn, commits on a nil return, and rolls back otherwise.
+// If fn returns a *pgconn.PgError with SQLSTATE 40001 (serialization_failure) or 40P01 (deadlock_detected),
+// the transaction is retried from the beginning with exponential backoff, up to [Options.TxRetry] additional
+// attempts. This replaces the "did commit or rollback happen?" logging heuristic callers previously had to
+// infer from [Disconnect] with first-class support for SERIALIZABLE isolation.
+func WithTx(ctx context.Context, conn *pgxpool.Conn, txoptions pgx.TxOptions, fn func(pgx.Tx) error, options ...Variadic) error {
+	settings := Settings()
+	for _, option := range options {
+		option(settings)
+	}
+
+	var e error
+	backoff := 50 * time.Millisecond
+
+	for attempt := 0; attempt <= settings.TxRetry; attempt++ {
+		if attempt > 0 {
+			slog.WarnContext(ctx, "Retrying Transaction", slog.Int("attempt", attempt), slog.Duration("backoff", backoff), slog.String("error", e.Error()))
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+		}
+
+		e = transact(ctx, conn, txoptions, fn)
+		if e == nil || !retryable(e) {
+			return e
+		}
+	}
+
+	return e
+}
+
+// transact runs a single attempt of fn inside a transaction, committing on success and rolling back otherwise.
+func transact(ctx context.Context, conn *pgxpool.Conn, txoptions pgx.TxOptions, fn func(pgx.Tx) error) error {
+	spanned, done := span(ctx, "pg.Begin")
+	tx, e := conn.BeginTx(spanned, txoptions)
+	done(e)
+	if e != nil {
+		return e
+	}
+
+	if e = fn(tx); e != nil {
+		if rollback := tx.Rollback(ctx); rollback != nil && !errors.Is(rollback, pgx.ErrTxClosed) {
+			slog.ErrorContext(ctx, "Error Rolling Back Transaction", slog.String("error", rollback.Error()))
+		}
+
+		return e
+	}
+
+	return tx.Commit(ctx)
+}
+
+// retryable reports whether e is a serialization failure or deadlock that's safe to retry the whole
+// transaction for.
+func retryable(e error) bool {
+	var pgerr *pgconn.PgError
+	if !errors.As(e, &pgerr) {
+		return false
+	}
+
+	switch pgerr.Code {
+	case "40001", "40P01":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,32 @@
+package pg
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestRetryable(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "serialization failure", err: &pgconn.PgError{Code: "40001"}, want: true},
+		{name: "deadlock detected", err: &pgconn.PgError{Code: "40P01"}, want: true},
+		{name: "other pg error", err: &pgconn.PgError{Code: "23505"}, want: false},
+		{name: "wrapped serialization failure", err: fmt.Errorf("tx failed: %w", &pgconn.PgError{Code: "40001"}), want: true},
+		{name: "non-pg error", err: errors.New("boom"), want: false},
+		{name: "nil", err: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := retryable(c.err); got != c.want {
+				t.Errorf("retryable(%v) = %v, want %v", c.err, got, c.want)
+			}
+		})
+	}
+}